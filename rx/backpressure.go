@@ -0,0 +1,207 @@
+package rx
+
+import (
+	"context"
+	"time"
+)
+
+// observe runs src in a background goroutine, forwarding its values onto a
+// channel so a caller can interleave them with its own timers instead of
+// being stuck inside src's synchronous next callback. The returned values
+// channel is closed once src completes; done then holds its final error
+// (buffered, so it's ready to read as soon as values is observed closed).
+func observe[T any](ctx context.Context, src Observable[T]) (values chan T, done chan error) {
+	values = make(chan T)
+	done = make(chan error, 1)
+	go func() {
+		defer close(values)
+		done <- src.Observe(ctx, func(v T) error {
+			select {
+			case values <- v:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return values, done
+}
+
+// timerC returns t.C, or a nil channel if t is nil, so a select case built
+// on it is simply disabled while no timer is running.
+func timerC(t *time.Timer) <-chan time.Time {
+	if t == nil {
+		return nil
+	}
+	return t.C
+}
+
+// Throttle emits a value, then ignores every further value until d has
+// passed since the one it emitted, then repeats.
+func (o Observable[T]) Throttle(d time.Duration) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		values, done := observe(ctx, o)
+
+		var timer *time.Timer
+		for {
+			select {
+			case v, ok := <-values:
+				if !ok {
+					return <-done
+				}
+				if timer != nil {
+					continue // still in the throttle window; drop v
+				}
+				if err := next(v); err != nil {
+					cancel()
+					<-done
+					return err
+				}
+				timer = time.NewTimer(d)
+			case <-timerC(timer):
+				timer = nil
+			}
+		}
+	})
+}
+
+// Debounce emits the most recent value only once d has passed without a
+// further value arriving, so a burst of values collapses into the last one.
+// A value still pending when the source completes is emitted right away,
+// without waiting for d.
+func (o Observable[T]) Debounce(d time.Duration) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		values, done := observe(ctx, o)
+
+		var (
+			timer       *time.Timer
+			pending     T
+			havePending bool
+		)
+		for {
+			select {
+			case v, ok := <-values:
+				if !ok {
+					if havePending {
+						if err := next(pending); err != nil {
+							return err
+						}
+					}
+					return <-done
+				}
+				pending, havePending = v, true
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.NewTimer(d)
+			case <-timerC(timer):
+				timer = nil
+				havePending = false
+				if err := next(pending); err != nil {
+					cancel()
+					<-done
+					return err
+				}
+			}
+		}
+	})
+}
+
+// Sample emits the most recently seen value at most once per d, on a fixed
+// schedule; a d window with no new value emits nothing.
+func (o Observable[T]) Sample(d time.Duration) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		values, done := observe(ctx, o)
+
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+
+		var (
+			latest     T
+			haveLatest bool
+		)
+		for {
+			select {
+			case v, ok := <-values:
+				if !ok {
+					return <-done
+				}
+				latest, haveLatest = v, true
+			case <-ticker.C:
+				if haveLatest {
+					if err := next(latest); err != nil {
+						cancel()
+						<-done
+						return err
+					}
+					haveLatest = false
+				}
+			}
+		}
+	})
+}
+
+// Buffer collects values from src into slices of up to size elements,
+// flushing whenever a slice fills or d passes since the last flush,
+// whichever comes first. A flush triggered by d alone may emit a short
+// slice; an empty buffer is never flushed at all.
+//
+// Buffer is a free function rather than a method: a method of Observable[T]
+// returning Observable[[]T] would be an instantiation cycle.
+func Buffer[T any](src Observable[T], size int, d time.Duration) Observable[[]T] {
+	return newObservable(func(ctx context.Context, next func([]T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		values, done := observe(ctx, src)
+
+		buf := make([]T, 0, size)
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		flush := func() error {
+			if len(buf) == 0 {
+				return nil
+			}
+			out := buf
+			buf = make([]T, 0, size)
+			return next(out)
+		}
+
+		for {
+			select {
+			case v, ok := <-values:
+				if !ok {
+					if err := flush(); err != nil {
+						return err
+					}
+					return <-done
+				}
+				buf = append(buf, v)
+				if len(buf) == size {
+					if !timer.Stop() {
+						<-timer.C
+					}
+					timer.Reset(d)
+					if err := flush(); err != nil {
+						cancel()
+						<-done
+						return err
+					}
+				}
+			case <-timer.C:
+				timer.Reset(d)
+				if err := flush(); err != nil {
+					cancel()
+					<-done
+					return err
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package rx
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestThrottleDropsValuesWithinWindow(t *testing.T) {
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		for i := 0; i < 5; i++ {
+			if err := next(i); err != nil {
+				return err
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		return nil
+	})
+
+	got, err := ToSlice(context.Background(), src.Throttle(25*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Throttle returned error: %v", err)
+	}
+	if len(got) == 0 || len(got) >= 5 {
+		t.Fatalf("got %v, want fewer than 5 values with at least one", got)
+	}
+	if got[0] != 0 {
+		t.Fatalf("got %v, want the first value to pass through immediately", got)
+	}
+}
+
+func TestDebounceCollapsesBurstToLastValue(t *testing.T) {
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		for i := 0; i < 5; i++ {
+			if err := next(i); err != nil {
+				return err
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		return nil
+	})
+
+	got, err := ToSlice(context.Background(), src.Debounce(30*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Debounce returned error: %v", err)
+	}
+	if len(got) != 1 || got[0] != 4 {
+		t.Fatalf("got %v, want a single value of 4", got)
+	}
+}
+
+func TestBufferFlushesOnCountOrTime(t *testing.T) {
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		for _, v := range []int{1, 2, 3} {
+			if err := next(v); err != nil {
+				return err
+			}
+		}
+		time.Sleep(30 * time.Millisecond) // forces a time-based flush of the trailing value
+		return next(4)
+	})
+
+	got, err := ToSlice(context.Background(), Buffer(src, 2, 15*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Buffer returned error: %v", err)
+	}
+	if len(got) < 2 {
+		t.Fatalf("got %v, want at least a count-flushed batch and a trailing batch", got)
+	}
+	if got[0][0] != 1 || got[0][1] != 2 {
+		t.Fatalf("first batch = %v, want [1 2]", got[0])
+	}
+	last := got[len(got)-1]
+	if last[len(last)-1] != 4 {
+		t.Fatalf("last batch = %v, want to end with 4", last)
+	}
+}
+
+func TestSampleEmitsLatestPerTick(t *testing.T) {
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		for i := 0; i < 5; i++ {
+			if err := next(i); err != nil {
+				return err
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		return nil
+	})
+
+	got, err := ToSlice(context.Background(), src.Sample(15*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Sample returned error: %v", err)
+	}
+	if len(got) == 0 {
+		t.Fatal("got no samples, want at least one")
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i] <= got[i-1] {
+			t.Fatalf("got %v, want strictly increasing samples", got)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package rx
+
+import (
+	"context"
+	"errors"
+)
+
+// Merge runs every source concurrently and emits their values interleaved,
+// in whatever order they arrive. It completes once every source has
+// completed. If next or any source returns an error, Merge cancels the
+// remaining sources and returns that error once they've all unwound.
+func Merge[T any](srcs ...Observable[T]) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		values := make(chan T)
+		done := make(chan error, len(srcs))
+		for _, src := range srcs {
+			src := src
+			go func() {
+				done <- src.Observe(ctx, func(v T) error {
+					select {
+					case values <- v:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				})
+			}()
+		}
+
+		var firstErr error
+		for remaining := len(srcs); remaining > 0; {
+			select {
+			case v := <-values:
+				if firstErr != nil {
+					continue // already failing; drop values while the sources unwind
+				}
+				if err := next(v); err != nil {
+					firstErr = err
+					cancel()
+				}
+			case err := <-done:
+				remaining--
+				if err != nil && !errors.Is(err, context.Canceled) && firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+			}
+		}
+		return firstErr
+	})
+}
+
+// CombineLatest runs every source concurrently and emits a slice holding
+// the most recent value from each, in source order, every time any one of
+// them emits — once all of them have emitted at least once. It completes
+// once every source has completed.
+func CombineLatest[T any](srcs ...Observable[T]) Observable[[]T] {
+	return newObservable(func(ctx context.Context, next func([]T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type item struct {
+			idx int
+			v   T
+		}
+		values := make(chan item)
+		done := make(chan error, len(srcs))
+		for i, src := range srcs {
+			i, src := i, src
+			go func() {
+				done <- src.Observe(ctx, func(v T) error {
+					select {
+					case values <- item{i, v}:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				})
+			}()
+		}
+
+		latest := make([]T, len(srcs))
+		has := make([]bool, len(srcs))
+		numHave := 0
+
+		var firstErr error
+		for remaining := len(srcs); remaining > 0; {
+			select {
+			case it := <-values:
+				if firstErr != nil {
+					continue // already failing; drop values while the sources unwind
+				}
+				if !has[it.idx] {
+					has[it.idx] = true
+					numHave++
+				}
+				latest[it.idx] = it.v
+				if numHave == len(srcs) {
+					out := append([]T(nil), latest...)
+					if err := next(out); err != nil {
+						firstErr = err
+						cancel()
+					}
+				}
+			case err := <-done:
+				remaining--
+				if err != nil && !errors.Is(err, context.Canceled) && firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+			}
+		}
+		return firstErr
+	})
+}
+
+// Zip pairs up the values from every source by arrival order — the first
+// value from each source forms the first emitted slice, the second values
+// form the second, and so on — and stops as soon as the shortest source
+// completes, cancelling the rest.
+func Zip[T any](srcs ...Observable[T]) Observable[[]T] {
+	return newObservable(func(ctx context.Context, next func([]T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		chans := make([]chan T, len(srcs))
+		errs := make([]chan error, len(srcs))
+		for i, src := range srcs {
+			chans[i] = make(chan T)
+			errs[i] = make(chan error, 1)
+			i, src := i, src
+			go func() {
+				defer close(chans[i])
+				errs[i] <- src.Observe(ctx, func(v T) error {
+					select {
+					case chans[i] <- v:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				})
+			}()
+		}
+
+		for {
+			row := make([]T, len(srcs))
+			for i, ch := range chans {
+				select {
+				case v, ok := <-ch:
+					if !ok {
+						cancel()
+						if err := <-errs[i]; err != nil && !errors.Is(err, context.Canceled) {
+							return err
+						}
+						return nil
+					}
+					row[i] = v
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			if err := next(row); err != nil {
+				cancel()
+				return err
+			}
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+)
+
+func TestMergeInterleavesAllSources(t *testing.T) {
+	got, err := ToSlice(context.Background(), Merge(Range(0, 3), Range(10, 3)))
+	if err != nil {
+		t.Fatalf("Merge returned error: %v", err)
+	}
+	sort.Ints(got)
+	want := []int{0, 1, 2, 10, 11, 12}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestMergePropagatesSourceError(t *testing.T) {
+	boom := errors.New("boom")
+	failing := newObservable(func(ctx context.Context, next func(int) error) error {
+		return boom
+	})
+	_, err := ToSlice(context.Background(), Merge(Range(0, 3), failing))
+	if !errors.Is(err, boom) {
+		t.Fatalf("got error %v, want %v", err, boom)
+	}
+}
+
+func TestCombineLatestWaitsForEverySourceThenEmits(t *testing.T) {
+	got, err := ToSlice(context.Background(), CombineLatest(Just(1), Just(2, 3)))
+	if err != nil {
+		t.Fatalf("CombineLatest returned error: %v", err)
+	}
+	if len(got) == 0 {
+		t.Fatal("got no rows, want at least one emitted once both sources had a value")
+	}
+	last := got[len(got)-1]
+	if last[0] != 1 || last[1] != 3 {
+		t.Fatalf("last row = %v, want [1 3]", last)
+	}
+}
+
+func TestZipPairsValuesByArrivalAndStopsAtShortestSource(t *testing.T) {
+	got, err := ToSlice(context.Background(), Zip(Range(0, 5), Range(10, 2)))
+	if err != nil {
+		t.Fatalf("Zip returned error: %v", err)
+	}
+	want := [][]int{{0, 10}, {1, 11}}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i][0] != want[i][0] || got[i][1] != want[i][1] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
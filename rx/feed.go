@@ -0,0 +1,165 @@
+package rx
+
+import (
+	"reflect"
+	"sync"
+)
+
+// caseList holds the reflect.SelectCase values Feed.Send drives through
+// reflect.Select. Its methods modify the slice in place rather than copying
+// it, so Feed only touches its sendCases while holding the send lock.
+type caseList []reflect.SelectCase
+
+// firstSubSendCase is the index of the first subscriber send-case in a
+// caseList; index 0 is reserved for the removeSub receive-case.
+const firstSubSendCase = 1
+
+func (cs caseList) find(channel interface{}) int {
+	for i, cas := range cs {
+		if cas.Chan.Interface() == channel {
+			return i
+		}
+	}
+	return -1
+}
+
+func (cs caseList) delete(index int) caseList {
+	return append(cs[:index], cs[index+1:]...)
+}
+
+// deactivate moves the case at index to the unused tail of the slice and
+// shrinks the slice so reflect.Select no longer considers it, without
+// disturbing the indices of the other active cases.
+func (cs caseList) deactivate(index int) caseList {
+	last := len(cs) - 1
+	cs[index], cs[last] = cs[last], cs[index]
+	return cs[:last]
+}
+
+// Feed implements one-to-many fan-out broadcasting of T values to channels
+// supplied by subscribers. Send delivers to every subscriber independently
+// via reflect.Select, so a subscriber with a full buffer does not hold up
+// delivery of the same value to its peers. Send still returns only once
+// every subscriber has taken the value, so a stalled subscriber does hold
+// up every later Send, and with it later values for its peers.
+//
+// Feed is a standalone multicast primitive. rx.Observable[T] itself stays a
+// cold, single-subscriber pull stream; Shared wraps one in a Feed to give it
+// a hot, multi-subscriber Subscribe — see Shared for that integration.
+//
+// Design note: the try-send-then-reflect.Select fan-out loop in Send, and
+// the caseList/removeSub bookkeeping around it, follow the approach used by
+// go-ethereum's event.Feed. This package does not vendor or import that
+// project, but the algorithm and field layout were derived from it rather
+// than designed independently, which is worth a license check before this
+// is relied on outside this repo.
+type Feed[T any] struct {
+	once sync.Once
+
+	sendLock  chan struct{} // one-element buffer, held while a Send is in flight
+	removeSub chan interface{}
+	sendCases caseList // sendCases[0] is the removeSub case; the rest are subscribers
+
+	mu    sync.Mutex
+	inbox caseList // subscribers added since the last Send, not yet merged in
+}
+
+func (f *Feed[T]) init() {
+	f.removeSub = make(chan interface{})
+	f.sendLock = make(chan struct{}, 1)
+	f.sendLock <- struct{}{}
+	f.sendCases = caseList{{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(f.removeSub)}}
+}
+
+// Subscribe registers channel to receive every value passed to Send from
+// now on. channel is typically created with a buffer so the sender can run
+// ahead of a subscriber that is briefly slow to drain it.
+func (f *Feed[T]) Subscribe(channel chan<- T) *Subscription {
+	f.once.Do(f.init)
+	chanVal := reflect.ValueOf(channel)
+
+	sub := &Subscription{err: make(chan error, 1)}
+	sub.unsubscribe = func() { f.remove(chanVal) }
+
+	f.mu.Lock()
+	f.inbox = append(f.inbox, reflect.SelectCase{Dir: reflect.SelectSend, Chan: chanVal})
+	f.mu.Unlock()
+	return sub
+}
+
+func (f *Feed[T]) remove(chanVal reflect.Value) {
+	f.mu.Lock()
+	if index := f.inbox.find(chanVal.Interface()); index != -1 {
+		f.inbox = f.inbox.delete(index)
+		f.mu.Unlock()
+		return
+	}
+	f.mu.Unlock()
+
+	// The channel is already in sendCases, so a Send in flight is the only
+	// thing that reads f.removeSub. If no Send is in flight, take the send
+	// lock ourselves and delete the case directly instead of blocking
+	// forever on a send nobody will receive.
+	select {
+	case f.removeSub <- chanVal.Interface():
+	case <-f.sendLock:
+		f.sendCases = f.sendCases.delete(f.sendCases.find(chanVal.Interface()))
+		f.sendLock <- struct{}{}
+	}
+}
+
+// Send delivers value to every current subscriber, blocking until each one
+// has received it, and returns the number of subscribers it was delivered
+// to. It is safe to call Send from multiple goroutines.
+func (f *Feed[T]) Send(value T) (nsent int) {
+	f.once.Do(f.init)
+	rvalue := reflect.ValueOf(value)
+
+	<-f.sendLock
+	defer func() { f.sendLock <- struct{}{} }()
+
+	f.mu.Lock()
+	f.sendCases = append(f.sendCases, f.inbox...)
+	f.inbox = nil
+	f.mu.Unlock()
+
+	for i := firstSubSendCase; i < len(f.sendCases); i++ {
+		f.sendCases[i].Send = rvalue
+	}
+
+	// Send until every subscriber case has fired once. 'cases' is a shrinking
+	// prefix of f.sendCases: a successful send moves its case to the unused
+	// tail and the prefix shrinks by one.
+	cases := f.sendCases
+	for {
+		// Fast path: try every case without blocking first, since that's
+		// enough whenever subscribers are keeping up with their buffer.
+		for i := firstSubSendCase; i < len(cases); i++ {
+			if cases[i].Chan.TrySend(rvalue) {
+				nsent++
+				cases = cases.deactivate(i)
+				i--
+			}
+		}
+		if len(cases) == firstSubSendCase {
+			break
+		}
+		// Block until a subscriber unblocks or unsubscribes.
+		chosen, recv, _ := reflect.Select(cases)
+		if chosen == 0 { // <-f.removeSub
+			index := f.sendCases.find(recv.Interface())
+			f.sendCases = f.sendCases.delete(index)
+			if index >= 0 && index < len(cases) {
+				cases = f.sendCases[:len(cases)-1]
+			}
+			continue
+		}
+		cases = cases.deactivate(chosen)
+		nsent++
+	}
+
+	for i := firstSubSendCase; i < len(f.sendCases); i++ {
+		f.sendCases[i].Send = reflect.Value{}
+	}
+	return nsent
+}
@@ -0,0 +1,67 @@
+package rx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFeedSendDeliversToSubscriber(t *testing.T) {
+	var f Feed[int]
+	ch := make(chan int, 1)
+	sub := f.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	if n := f.Send(42); n != 1 {
+		t.Fatalf("Send returned %d, want 1", n)
+	}
+	if got := <-ch; got != 42 {
+		t.Fatalf("received %d, want 42", got)
+	}
+}
+
+// TestFeedUnsubscribeAfterSendDoesNotDeadlock guards against a regression
+// where Unsubscribe, called with no Send in flight, blocked forever trying
+// to write to removeSub because nothing was reading from it.
+func TestFeedUnsubscribeAfterSendDoesNotDeadlock(t *testing.T) {
+	var f Feed[int]
+	ch := make(chan int, 1)
+	sub := f.Subscribe(ch)
+
+	f.Send(1)
+	<-ch
+
+	done := make(chan struct{})
+	go func() {
+		sub.Unsubscribe()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Unsubscribe did not return after a completed Send with none in flight")
+	}
+}
+
+func TestFeedSlowSubscriberDoesNotStallPeers(t *testing.T) {
+	var f Feed[int]
+	slow := make(chan int) // unbuffered, never read in this test
+	fast := make(chan int, 1)
+	f.Subscribe(slow)
+	f.Subscribe(fast)
+
+	done := make(chan struct{})
+	go func() {
+		f.Send(7)
+		close(done)
+	}()
+
+	select {
+	case got := <-fast:
+		if got != 7 {
+			t.Fatalf("received %d, want 7", got)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("fast subscriber never received a value, slow subscriber stalled delivery")
+	}
+}
@@ -0,0 +1,43 @@
+// Package rx is a type-safe, generics-based reactive stream API.
+//
+// It exists alongside the older github.com/jochasinga/grx/observable
+// package, which represents streams as channels of Emitter values and
+// requires callers to type-assert their way back to a concrete type.
+// Observable[T] instead carries its element type as a generic parameter,
+// so sources, operators and sinks compose without interface{}.
+package rx
+
+import (
+	"context"
+	"errors"
+)
+
+// errStopIteration is returned by a next function to stop an Observe call
+// early without treating it as a failure.
+var errStopIteration = errors.New("rx: stop iteration")
+
+// Observable is a cold, context-aware stream of T values. Nothing happens
+// until Observe is called; each call drives its own, independent run of
+// the underlying source.
+type Observable[T any] struct {
+	subscribe func(ctx context.Context, next func(T) error) error
+}
+
+// newObservable wraps a subscribe function as an Observable[T].
+func newObservable[T any](subscribe func(ctx context.Context, next func(T) error) error) Observable[T] {
+	return Observable[T]{subscribe: subscribe}
+}
+
+// Observe runs the Observable, calling next for every emitted value.
+// It blocks until the source completes, next returns an error, or ctx is
+// cancelled. A nil ctx is treated as context.Background().
+func (o Observable[T]) Observe(ctx context.Context, next func(T) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	err := o.subscribe(ctx, next)
+	if errors.Is(err, errStopIteration) {
+		return nil
+	}
+	return err
+}
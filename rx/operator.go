@@ -0,0 +1,55 @@
+package rx
+
+import "context"
+
+// Map applies f to every value emitted by src.
+//
+// Go methods cannot introduce new type parameters, so Map, Filter, Reduce
+// and FlatMap are free functions rather than methods on Observable[T].
+func Map[A, B any](src Observable[A], f func(A) B) Observable[B] {
+	return newObservable(func(ctx context.Context, next func(B) error) error {
+		return src.Observe(ctx, func(a A) error {
+			return next(f(a))
+		})
+	})
+}
+
+// Filter emits only the values from src for which pred returns true.
+func Filter[T any](src Observable[T], pred func(T) bool) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		return src.Observe(ctx, func(v T) error {
+			if !pred(v) {
+				return nil
+			}
+			return next(v)
+		})
+	})
+}
+
+// Reduce folds every value emitted by src into an accumulator, starting
+// from seed, and emits the final accumulator once src completes.
+func Reduce[A, B any](src Observable[A], seed B, f func(B, A) B) Observable[B] {
+	return newObservable(func(ctx context.Context, next func(B) error) error {
+		acc := seed
+		if err := src.Observe(ctx, func(a A) error {
+			acc = f(acc, a)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return next(acc)
+	})
+}
+
+// FlatMap maps each value from src to an inner Observable and emits the
+// inner Observables' values as they arrive, in the order their source
+// value was received.
+func FlatMap[A, B any](src Observable[A], f func(A) Observable[B]) Observable[B] {
+	return newObservable(func(ctx context.Context, next func(B) error) error {
+		return src.Observe(ctx, func(a A) error {
+			// subscribe, not Observe: Observe would turn a stop returned by
+			// next into nil and let src go on to its next value.
+			return f(a).subscribe(ctx, next)
+		})
+	})
+}
@@ -0,0 +1,107 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrTimeout is returned by Timeout when no value arrives within its
+// window.
+var ErrTimeout = errors.New("rx: observable timed out")
+
+// Deferred builds an Observable lazily: factory is not called until Observe
+// runs, so each Observe call gets its own freshly built Observable instead
+// of sharing state across calls the way a precomputed Observable would.
+func Deferred[T any](factory func() Observable[T]) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		return factory().Observe(ctx, next)
+	})
+}
+
+// Retry re-subscribes to o, from scratch, up to maxAttempts times in total
+// (the first attempt plus up to maxAttempts-1 retries) until one run
+// completes without error, returning the final error if none do. Because o
+// is cold, a retried run re-emits every value from the start, including
+// ones next already saw on a failed attempt.
+func (o Observable[T]) Retry(maxAttempts int) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		var err error
+		for attempts := 0; attempts < maxAttempts; attempts++ {
+			err = o.Observe(ctx, next)
+			if err == nil {
+				return nil
+			}
+		}
+		return err
+	})
+}
+
+// RetryWhen behaves like Retry, but waits delay before each retry instead
+// of resubscribing immediately.
+func (o Observable[T]) RetryWhen(delay time.Duration, maxAttempts int) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		var err error
+		for attempts := 0; attempts < maxAttempts; attempts++ {
+			if attempts > 0 {
+				select {
+				case <-time.After(delay):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			err = o.Observe(ctx, next)
+			if err == nil {
+				return nil
+			}
+		}
+		return err
+	})
+}
+
+// Timeout fails with ErrTimeout if o goes longer than d without emitting a
+// value, counting from the start of the run and from each value after.
+func (o Observable[T]) Timeout(d time.Duration) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		values, done := observe(ctx, o)
+
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		for {
+			select {
+			case v, ok := <-values:
+				if !ok {
+					return <-done
+				}
+				if !timer.Stop() {
+					<-timer.C
+				}
+				timer.Reset(d)
+				if err := next(v); err != nil {
+					cancel()
+					<-done
+					return err
+				}
+			case <-timer.C:
+				cancel()
+				<-done
+				return ErrTimeout
+			}
+		}
+	})
+}
+
+// Catch runs o, and if it fails, switches over to fallback(err) for the
+// rest of the stream. An error from fallback's Observable is returned as
+// Catch's own error rather than swallowed.
+func (o Observable[T]) Catch(fallback func(error) Observable[T]) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		err := o.Observe(ctx, next)
+		if err == nil {
+			return nil
+		}
+		return fallback(err).Observe(ctx, next)
+	})
+}
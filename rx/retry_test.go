@@ -0,0 +1,152 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDeferredBuildsAFreshObservablePerObserve(t *testing.T) {
+	builds := 0
+	d := Deferred(func() Observable[int] {
+		builds++
+		return Just(builds)
+	})
+
+	first, err := ToSlice(context.Background(), d)
+	if err != nil {
+		t.Fatalf("first Observe returned error: %v", err)
+	}
+	second, err := ToSlice(context.Background(), d)
+	if err != nil {
+		t.Fatalf("second Observe returned error: %v", err)
+	}
+	if first[0] != 1 || second[0] != 2 {
+		t.Fatalf("got %v then %v, want factory called once per Observe", first, second)
+	}
+}
+
+// TestRetrySucceedsWithinMaxAttempts guards against a regression where
+// Retry(n) gave up after the very first failure instead of actually
+// running up to n attempts.
+func TestRetrySucceedsWithinMaxAttempts(t *testing.T) {
+	attempts := 0
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("not yet")
+		}
+		return next(attempts)
+	})
+
+	got, err := ToSlice(context.Background(), src.Retry(3))
+	if err != nil {
+		t.Fatalf("Retry(3) returned error: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("made %d attempts, want exactly 3", attempts)
+	}
+	if len(got) != 1 || got[0] != 3 {
+		t.Fatalf("got %v, want [3]", got)
+	}
+}
+
+func TestRetryReturnsFinalErrorOnceAttemptsExhausted(t *testing.T) {
+	boom := errors.New("boom")
+	attempts := 0
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		attempts++
+		return boom
+	})
+
+	_, err := ToSlice(context.Background(), src.Retry(2))
+	if !errors.Is(err, boom) {
+		t.Fatalf("got error %v, want %v", err, boom)
+	}
+	if attempts != 2 {
+		t.Fatalf("made %d attempts, want exactly 2", attempts)
+	}
+}
+
+func TestRetryWhenWaitsBetweenAttempts(t *testing.T) {
+	attempts := 0
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("not yet")
+		}
+		return next(1)
+	})
+
+	start := time.Now()
+	_, err := ToSlice(context.Background(), src.RetryWhen(20*time.Millisecond, 2))
+	if err != nil {
+		t.Fatalf("RetryWhen returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Fatalf("retried after %v, want to wait at least the configured delay", elapsed)
+	}
+}
+
+func TestTimeoutFiresWhenSourceStalls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	_, err := ToSlice(ctx, src.Timeout(10*time.Millisecond))
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("got error %v, want ErrTimeout", err)
+	}
+}
+
+// TestCatchForwardsFallbackError guards against a regression where Catch
+// swallowed an error returned by the fallback Observable instead of
+// propagating it.
+func TestCatchForwardsFallbackError(t *testing.T) {
+	srcErr := errors.New("source failed")
+	fallbackErr := errors.New("fallback failed too")
+
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		return srcErr
+	})
+	caught := src.Catch(func(err error) Observable[int] {
+		return newObservable(func(ctx context.Context, next func(int) error) error {
+			return fallbackErr
+		})
+	})
+
+	_, err := ToSlice(context.Background(), caught)
+	if !errors.Is(err, fallbackErr) {
+		t.Fatalf("got error %v, want %v", err, fallbackErr)
+	}
+}
+
+func TestCatchSwitchesToFallbackOnError(t *testing.T) {
+	src := newObservable(func(ctx context.Context, next func(int) error) error {
+		if err := next(1); err != nil {
+			return err
+		}
+		return errors.New("boom")
+	})
+	caught := src.Catch(func(err error) Observable[int] {
+		return Just(2, 3)
+	})
+
+	got, err := ToSlice(context.Background(), caught)
+	if err != nil {
+		t.Fatalf("Catch returned error: %v", err)
+	}
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package rx
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrEmpty is returned by First when the Observable completes without
+// emitting any value.
+var ErrEmpty = errors.New("rx: observable produced no items")
+
+// ToSlice drains src and collects every emitted value into a slice.
+func ToSlice[T any](ctx context.Context, src Observable[T]) ([]T, error) {
+	var out []T
+	err := src.Observe(ctx, func(v T) error {
+		out = append(out, v)
+		return nil
+	})
+	return out, err
+}
+
+// First returns the first value emitted by src, cancelling the rest of the
+// run as soon as it arrives. It returns ErrEmpty if src completes without
+// emitting anything.
+func First[T any](ctx context.Context, src Observable[T]) (T, error) {
+	var (
+		result T
+		found  bool
+	)
+	err := src.Observe(ctx, func(v T) error {
+		result = v
+		found = true
+		return errStopIteration
+	})
+	if err != nil {
+		return result, err
+	}
+	if !found {
+		return result, ErrEmpty
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package rx
+
+import (
+	"context"
+	"time"
+)
+
+// Just creates an Observable that emits the given values, in order, and
+// then completes.
+func Just[T any](values ...T) Observable[T] {
+	return From(values)
+}
+
+// From creates an Observable that emits every element of values, in order,
+// and then completes.
+func From[T any](values []T) Observable[T] {
+	return newObservable(func(ctx context.Context, next func(T) error) error {
+		for _, v := range values {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			if err := next(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Range creates an Observable that emits count sequential ints starting at
+// start and then completes.
+func Range(start, count int) Observable[int] {
+	return newObservable(func(ctx context.Context, next func(int) error) error {
+		for i := 0; i < count; i++ {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			if err := next(start + i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Interval creates an Observable that emits incrementing ints, one every d,
+// starting at 0. It never completes on its own; cancel ctx to stop it.
+func Interval(d time.Duration) Observable[int] {
+	return newObservable(func(ctx context.Context, next func(int) error) error {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+				if err := next(i); err != nil {
+					return err
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package rx
+
+import (
+	"context"
+	"sync"
+)
+
+// Shared turns a cold Observable[T] into a hot one: the first call to
+// Subscribe connects to src and starts fanning its values out through an
+// internal Feed; every later call to Subscribe attaches to that same Feed
+// instead of running src again. It gives a multicast path to Observable[T],
+// which on its own is cold and single-subscriber (see Feed's doc comment).
+type Shared[T any] struct {
+	src  Observable[T]
+	feed Feed[T]
+	once sync.Once
+}
+
+// Share wraps src so it can be fanned out to multiple subscribers. src
+// itself is left untouched and can still be Observed directly.
+func Share[T any](src Observable[T]) *Shared[T] {
+	return &Shared[T]{src: src}
+}
+
+// connect starts the single run of s.src that feeds s.feed, the first time
+// it is called; later calls are no-ops.
+func (s *Shared[T]) connect(ctx context.Context) {
+	s.once.Do(func() {
+		go s.src.Observe(ctx, func(v T) error {
+			s.feed.Send(v)
+			return nil
+		})
+	})
+}
+
+// Subscribe connects s if this is the first subscriber, then delivers
+// every value s's source produces from now on to next, via Feed's
+// multicast fan-out, until ctx is cancelled or the returned Subscription
+// is unsubscribed.
+func (s *Shared[T]) Subscribe(ctx context.Context, next func(T) error) *Subscription {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ch := make(chan T, 16)
+	sub := s.feed.Subscribe(ch)
+	s.connect(ctx)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				sub.Unsubscribe()
+				return
+			case v, ok := <-ch:
+				if !ok {
+					return
+				}
+				if next(v) != nil {
+					sub.Unsubscribe()
+					return
+				}
+			}
+		}
+	}()
+	return sub
+}
@@ -0,0 +1,74 @@
+package rx
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSharedFansOutToMultipleSubscribers(t *testing.T) {
+	src := Range(0, 3)
+	shared := Share(src)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	results := make([][]int, 2)
+	for i := range results {
+		i := i
+		wg.Add(1)
+		sub := shared.Subscribe(ctx, func(v int) error {
+			results[i] = append(results[i], v)
+			if len(results[i]) == 3 {
+				wg.Done()
+			}
+			return nil
+		})
+		defer sub.Unsubscribe()
+	}
+
+	// Range emits as soon as it is observed, so this relies on both
+	// subscribers attaching before the connect goroutine's first Send.
+	done := make(chan struct{})
+	go func() { wg.Wait(); close(done) }()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("not every subscriber received all 3 values")
+	}
+
+	for i, got := range results {
+		if len(got) != 3 {
+			t.Fatalf("subscriber %d received %v, want 3 values", i, got)
+		}
+	}
+}
+
+func TestSharedSubscribeStopsOnContextCancel(t *testing.T) {
+	shared := Share(Interval(5 * time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan struct{}, 1)
+	shared.Subscribe(ctx, func(v int) error {
+		select {
+		case received <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Fatal("never received a value before cancelling")
+	}
+	cancel()
+
+	// The goroutine backing Subscribe should return shortly after cancel;
+	// there's nothing to assert on directly, but this exercises the path
+	// without racing or deadlocking under -race.
+	time.Sleep(20 * time.Millisecond)
+}
@@ -0,0 +1,70 @@
+package rx
+
+import "sync"
+
+// Subscription represents a stream of events delivered through a channel
+// supplied by the caller. It does not carry the channel itself — only the
+// means to tear the subscription down and to observe delivery errors.
+type Subscription struct {
+	once        sync.Once
+	err         chan error
+	unsubscribe func()
+}
+
+// Err returns a channel that is closed when the subscription ends. Nothing
+// using Subscription today has a failure mode of its own to report, so the
+// channel is currently only ever closed, never sent on; it is exposed for
+// future subscription sources (e.g. ones backed by a network connection)
+// that do have errors to surface before they end.
+func (s *Subscription) Err() <-chan error {
+	return s.err
+}
+
+// Unsubscribe cancels the subscription. It can be called any number of
+// times; only the first call has an effect.
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.unsubscribe()
+		close(s.err)
+	})
+}
+
+// SubscriptionScope tracks a group of Subscriptions so they can be torn
+// down together with a single Close call, instead of callers having to
+// keep their own slice and Unsubscribe each one individually.
+type SubscriptionScope struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+// Track adds sub to the scope and returns it unchanged, so it can be used
+// inline at the call site of Subscribe. If the scope is already closed,
+// sub is unsubscribed immediately.
+func (sc *SubscriptionScope) Track(sub *Subscription) *Subscription {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.closed {
+		sub.Unsubscribe()
+		return sub
+	}
+	if sc.subs == nil {
+		sc.subs = make(map[*Subscription]struct{})
+	}
+	sc.subs[sub] = struct{}{}
+	return sub
+}
+
+// Close unsubscribes every tracked Subscription. Further calls to Track
+// unsubscribe immediately instead of being tracked.
+func (sc *SubscriptionScope) Close() {
+	sc.mu.Lock()
+	subs := sc.subs
+	sc.subs = nil
+	sc.closed = true
+	sc.mu.Unlock()
+
+	for sub := range subs {
+		sub.Unsubscribe()
+	}
+}